@@ -0,0 +1,73 @@
+// Command cpx-operator runs a controller that aggregates CompliancePosture
+// custom resources across a cluster and serves the result at /cpx.
+package main
+
+import (
+	"flag"
+	"net/http"
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+	"github.com/LowerPlane/OpenCPX/sdk/go/operator"
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = operator.AddToScheme(scheme)
+}
+
+func main() {
+	var metricsAddr, cpxAddr, organization string
+	var enableLeaderElection bool
+
+	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "address the metrics endpoint binds to")
+	flag.StringVar(&cpxAddr, "cpx-bind-address", ":8081", "address the /cpx endpoint binds to")
+	flag.StringVar(&organization, "organization", "default", "organization whose aggregated posture is served at /cpx")
+	flag.BoolVar(&enableLeaderElection, "leader-elect", false, "enable leader election for controller manager HA")
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New())
+	log := ctrl.Log.WithName("cpx-operator")
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:           scheme,
+		Metrics:          server.Options{BindAddress: metricsAddr},
+		LeaderElection:   enableLeaderElection,
+		LeaderElectionID: "cpx-operator.opencpx.io",
+	})
+	if err != nil {
+		log.Error(err, "unable to start manager")
+		os.Exit(1)
+	}
+
+	reconciler := operator.NewReconciler(mgr.GetClient())
+	if err := reconciler.SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to set up CompliancePosture reconciler")
+		os.Exit(1)
+	}
+
+	mux := http.NewServeMux()
+	cpx.RegisterHandler(mux, reconciler.Provider(organization))
+
+	go func() {
+		log.Info("serving /cpx", "address", cpxAddr)
+		if err := http.ListenAndServe(cpxAddr, mux); err != nil {
+			log.Error(err, "cpx server exited")
+			os.Exit(1)
+		}
+	}()
+
+	log.Info("starting manager")
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		log.Error(err, "manager exited with error")
+		os.Exit(1)
+	}
+}
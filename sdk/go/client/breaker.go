@@ -0,0 +1,47 @@
+package client
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker is a minimal consecutive-failure circuit breaker: after
+// failureThreshold consecutive failures it opens for cooldown, during
+// which calls are short-circuited without hitting the network.
+type breaker struct {
+	failureThreshold int
+	cooldown         time.Duration
+
+	mu          sync.Mutex
+	failures    int
+	openedUntil time.Time
+}
+
+func newBreaker(failureThreshold int, cooldown time.Duration) *breaker {
+	return &breaker{failureThreshold: failureThreshold, cooldown: cooldown}
+}
+
+// allow reports whether a call should be attempted.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return time.Now().After(b.openedUntil)
+}
+
+// recordSuccess resets the failure count.
+func (b *breaker) recordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = 0
+}
+
+// recordFailure increments the failure count, opening the breaker once
+// failureThreshold is reached.
+func (b *breaker) recordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures++
+	if b.failures >= b.failureThreshold {
+		b.openedUntil = time.Now().Add(b.cooldown)
+	}
+}
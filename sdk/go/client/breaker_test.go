@@ -0,0 +1,49 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBreakerOpensAfterThresholdFailures(t *testing.T) {
+	b := newBreaker(2, time.Hour)
+
+	if !b.allow() {
+		t.Fatal("allow() = false before any failures, want true")
+	}
+
+	b.recordFailure()
+	if !b.allow() {
+		t.Fatal("allow() = false after 1/2 failures, want true")
+	}
+
+	b.recordFailure()
+	if b.allow() {
+		t.Fatal("allow() = true after reaching failureThreshold, want false")
+	}
+}
+
+func TestBreakerClosesAfterCooldown(t *testing.T) {
+	b := newBreaker(1, time.Millisecond)
+
+	b.recordFailure()
+	if b.allow() {
+		t.Fatal("allow() = true immediately after opening, want false")
+	}
+
+	time.Sleep(5 * time.Millisecond)
+	if !b.allow() {
+		t.Fatal("allow() = false after cooldown elapsed, want true")
+	}
+}
+
+func TestBreakerRecordSuccessResetsFailureCount(t *testing.T) {
+	b := newBreaker(2, time.Hour)
+
+	b.recordFailure()
+	b.recordSuccess()
+	b.recordFailure()
+	if !b.allow() {
+		t.Fatal("allow() = false after success reset the failure count, want true")
+	}
+}
@@ -0,0 +1,76 @@
+// Package client provides an HTTP client for fetching OpenCPX documents,
+// and a Federator that rolls up several upstream documents into one.
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+// VersionError is returned by Fetch when an upstream serves a schema
+// version the client doesn't expect.
+type VersionError struct {
+	URL  string
+	Want string
+	Got  string
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("cpx client: %s: expected X-CPX-Version %q, got %q", e.URL, e.Want, e.Got)
+}
+
+// Client fetches Posture documents from CPX endpoints over HTTP.
+type Client struct {
+	// HTTPClient is used to make requests. http.DefaultClient is used if
+	// nil.
+	HTTPClient *http.Client
+}
+
+// New returns a Client using http.DefaultClient.
+func New() *Client {
+	return &Client{HTTPClient: http.DefaultClient}
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+// Fetch retrieves and decodes the Posture served at url. It negotiates
+// JSON via the Accept header and returns a *VersionError if the server's
+// X-CPX-Version header doesn't match the version this client understands.
+func (c *Client) Fetch(ctx context.Context, url string) (*cpx.Posture, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("cpx client: build request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("cpx client: fetch %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("cpx client: fetch %s: unexpected status %s: %s", url, resp.Status, body)
+	}
+
+	if got := resp.Header.Get("X-CPX-Version"); got != "" && got != cpx.Version {
+		return nil, &VersionError{URL: url, Want: cpx.Version, Got: got}
+	}
+
+	var posture cpx.Posture
+	if err := json.NewDecoder(resp.Body).Decode(&posture); err != nil {
+		return nil, fmt.Errorf("cpx client: decode %s: %w", url, err)
+	}
+	return &posture, nil
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+func TestFetchDecodesPosture(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-CPX-Version", cpx.Version)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"version":"v1","compliance_posture":"compliant","frameworks":[]}`))
+	}))
+	defer srv.Close()
+
+	posture, err := New().Fetch(context.Background(), srv.URL)
+	if err != nil {
+		t.Fatalf("Fetch: %v", err)
+	}
+	if posture.CompliancePosture != cpx.PostureCompliant {
+		t.Errorf("CompliancePosture = %q, want %q", posture.CompliancePosture, cpx.PostureCompliant)
+	}
+}
+
+func TestFetchRejectsVersionMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-CPX-Version", "v2")
+		w.Write([]byte(`{}`))
+	}))
+	defer srv.Close()
+
+	_, err := New().Fetch(context.Background(), srv.URL)
+	var verr *VersionError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Fetch: err = %v, want *VersionError", err)
+	}
+	if verr.Want != cpx.Version || verr.Got != "v2" {
+		t.Errorf("VersionError = %+v, want Want=%q Got=v2", verr, cpx.Version)
+	}
+}
+
+func TestFetchRejectsNonOKStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "nope", http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	if _, err := New().Fetch(context.Background(), srv.URL); err == nil {
+		t.Fatal("Fetch: err = nil, want an error for non-200 status")
+	}
+}
@@ -0,0 +1,159 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+// Upstream is one CPX endpoint a Federator rolls up.
+type Upstream struct {
+	Name string
+	URL  string
+}
+
+// SourceStatus records how the most recent fetch of one upstream went. A
+// Federator records one of these per upstream under
+// Extensions["sources"][name] in the Posture it produces.
+type SourceStatus struct {
+	Status        string    `json:"status"`
+	LatencyMS     int64     `json:"latency_ms"`
+	LastSuccessAt time.Time `json:"last_success_at,omitempty"`
+	Error         string    `json:"error,omitempty"`
+}
+
+const (
+	sourceStatusOK          = "ok"
+	sourceStatusUnreachable = "unreachable"
+	sourceStatusOpen        = "circuit_open"
+)
+
+// Federator fetches several upstream CPX documents concurrently and merges
+// them into a single Posture, recording per-upstream health under
+// Extensions["sources"].
+type Federator struct {
+	Upstreams []Upstream
+	Client    *Client
+
+	// Timeout bounds each individual upstream fetch. Defaults to 5s.
+	Timeout time.Duration
+	// FailureThreshold is the number of consecutive failures before an
+	// upstream's circuit opens. Defaults to 3.
+	FailureThreshold int
+	// Cooldown is how long an open circuit stays open before the next
+	// fetch is attempted again. Defaults to 30s.
+	Cooldown time.Duration
+
+	mu       sync.Mutex
+	breakers map[string]*breaker
+	last     map[string]SourceStatus
+}
+
+// NewFederator returns a Federator for upstreams with sensible defaults.
+func NewFederator(upstreams []Upstream) *Federator {
+	return &Federator{
+		Upstreams:        upstreams,
+		Client:           New(),
+		Timeout:          5 * time.Second,
+		FailureThreshold: 3,
+		Cooldown:         30 * time.Second,
+		breakers:         map[string]*breaker{},
+		last:             map[string]SourceStatus{},
+	}
+}
+
+func (f *Federator) breakerFor(name string) *breaker {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	b, ok := f.breakers[name]
+	if !ok {
+		b = newBreaker(f.FailureThreshold, f.Cooldown)
+		f.breakers[name] = b
+	}
+	return b
+}
+
+// Aggregate fetches every upstream concurrently, subject to per-upstream
+// timeouts and circuit breaking, and merges the results into one Posture.
+func (f *Federator) Aggregate(ctx context.Context) (*cpx.Posture, error) {
+	type result struct {
+		name    string
+		posture *cpx.Posture
+		status  SourceStatus
+	}
+
+	results := make([]result, len(f.Upstreams))
+	var wg sync.WaitGroup
+
+	for i, up := range f.Upstreams {
+		i, up := i, up
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			b := f.breakerFor(up.Name)
+			if !b.allow() {
+				results[i] = result{name: up.Name, status: SourceStatus{Status: sourceStatusOpen}}
+				return
+			}
+
+			start := time.Now()
+			reqCtx, cancel := context.WithTimeout(ctx, f.Timeout)
+			defer cancel()
+
+			posture, err := f.Client.Fetch(reqCtx, up.URL)
+			latency := time.Since(start)
+
+			if err != nil {
+				b.recordFailure()
+				results[i] = result{name: up.Name, status: SourceStatus{
+					Status:    sourceStatusUnreachable,
+					LatencyMS: latency.Milliseconds(),
+					Error:     err.Error(),
+				}}
+				return
+			}
+
+			b.recordSuccess()
+			now := time.Now()
+			results[i] = result{name: up.Name, posture: posture, status: SourceStatus{
+				Status:        sourceStatusOK,
+				LatencyMS:     latency.Milliseconds(),
+				LastSuccessAt: now,
+			}}
+		}()
+	}
+	wg.Wait()
+
+	merged := cpx.NewPosture()
+	sources := make(map[string]SourceStatus, len(results))
+
+	f.mu.Lock()
+	for _, r := range results {
+		if r.status.Status == sourceStatusOK {
+			f.last[r.name] = r.status
+		} else if prev, ok := f.last[r.name]; ok {
+			r.status.LastSuccessAt = prev.LastSuccessAt
+		}
+		sources[r.name] = r.status
+
+		if r.posture != nil {
+			mergeFrameworksInto(merged, r.posture.Frameworks)
+		}
+	}
+	f.mu.Unlock()
+
+	merged.SetPosture(merged.CalculateOverallPosture())
+	merged.AddExtension("sources", sources)
+	return merged, nil
+}
+
+// Provider adapts Aggregate to cpx.Provider so a Federator can be passed
+// directly to cpx.RegisterHandler.
+func (f *Federator) Provider() cpx.Provider {
+	return func() (*cpx.Posture, error) {
+		return f.Aggregate(context.Background())
+	}
+}
@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+func newUpstream(t *testing.T, name string, frameworks []cpx.Framework) Upstream {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-CPX-Version", cpx.Version)
+		p := cpx.NewPosture()
+		p.CompliancePosture = cpx.PostureCompliant
+		p.Frameworks = frameworks
+		data, err := p.ToJSON()
+		if err != nil {
+			t.Fatalf("ToJSON: %v", err)
+		}
+		w.Write(data)
+	}))
+	t.Cleanup(srv.Close)
+	return Upstream{Name: name, URL: srv.URL}
+}
+
+func TestFederatorAggregateMergesConcurrentUpstreams(t *testing.T) {
+	up1 := newUpstream(t, "a", []cpx.Framework{
+		{Name: "soc2", Status: cpx.StatusCompliant, Score: 1.0,
+			Controls: []cpx.Control{{ID: "cc-1", Status: cpx.ControlCompliant}}},
+	})
+	up2 := newUpstream(t, "b", []cpx.Framework{
+		{Name: "soc2", Status: cpx.StatusNonCompliant, Score: 0.2,
+			Controls: []cpx.Control{{ID: "cc-1", Status: cpx.ControlNonCompliant, Reason: "failing"}}},
+	})
+
+	f := NewFederator([]Upstream{up1, up2})
+	posture, err := f.Aggregate(context.Background())
+	if err != nil {
+		t.Fatalf("Aggregate: %v", err)
+	}
+
+	if len(posture.Frameworks) != 1 {
+		t.Fatalf("len(Frameworks) = %d, want 1", len(posture.Frameworks))
+	}
+	fw := posture.Frameworks[0]
+	if fw.Status != cpx.StatusNonCompliant {
+		t.Errorf("Framework.Status = %q, want %q (worst-of)", fw.Status, cpx.StatusNonCompliant)
+	}
+	if fw.Score != 0.2 {
+		t.Errorf("Framework.Score = %v, want 0.2 (worst-of)", fw.Score)
+	}
+
+	sources, ok := posture.Extensions["sources"].(map[string]SourceStatus)
+	if !ok {
+		t.Fatalf("Extensions[sources] type = %T, want map[string]SourceStatus", posture.Extensions["sources"])
+	}
+	if len(sources) != 2 {
+		t.Fatalf("len(sources) = %d, want 2", len(sources))
+	}
+	for _, name := range []string{"a", "b"} {
+		if sources[name].Status != sourceStatusOK {
+			t.Errorf("sources[%q].Status = %q, want %q", name, sources[name].Status, sourceStatusOK)
+		}
+	}
+}
+
+func TestFederatorAggregateRecordsUnreachableUpstream(t *testing.T) {
+	up := newUpstream(t, "a", nil)
+	down := Upstream{Name: "b", URL: "http://127.0.0.1:1"}
+
+	f := NewFederator([]Upstream{up, down})
+	posture, err := f.Aggregate(context.Background())
+	if err != nil {
+		t.Fatalf("Aggregate: %v", err)
+	}
+
+	sources := posture.Extensions["sources"].(map[string]SourceStatus)
+	if sources["b"].Status != sourceStatusUnreachable {
+		t.Errorf(`sources["b"].Status = %q, want %q`, sources["b"].Status, sourceStatusUnreachable)
+	}
+	if sources["b"].Error == "" {
+		t.Error(`sources["b"].Error = "", want a recorded error message`)
+	}
+}
+
+func TestFederatorAggregateSkipsOpenCircuit(t *testing.T) {
+	down := Upstream{Name: "flaky", URL: "http://127.0.0.1:1"}
+	f := NewFederator([]Upstream{down})
+	f.FailureThreshold = 1
+	f.Cooldown = time.Hour
+
+	if _, err := f.Aggregate(context.Background()); err != nil {
+		t.Fatalf("Aggregate: %v", err)
+	}
+
+	posture, err := f.Aggregate(context.Background())
+	if err != nil {
+		t.Fatalf("Aggregate: %v", err)
+	}
+
+	sources := posture.Extensions["sources"].(map[string]SourceStatus)
+	if sources["flaky"].Status != sourceStatusOpen {
+		t.Errorf(`sources["flaky"].Status = %q, want %q (breaker should have opened)`, sources["flaky"].Status, sourceStatusOpen)
+	}
+}
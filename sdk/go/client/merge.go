@@ -0,0 +1,62 @@
+package client
+
+import cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+
+var frameworkRank = map[cpx.FrameworkStatus]int{
+	cpx.StatusCompliant:    0,
+	cpx.StatusPartial:      1,
+	cpx.StatusNonCompliant: 2,
+}
+
+var controlRank = map[cpx.ControlStatus]int{
+	cpx.ControlCompliant:    0,
+	cpx.ControlPartial:      1,
+	cpx.ControlNonCompliant: 2,
+}
+
+// mergeFrameworksInto unions incoming into merged by framework name,
+// taking the worst-of status and score when a framework is reported by
+// more than one upstream, and unioning controls by ID the same way.
+func mergeFrameworksInto(merged *cpx.Posture, incoming []cpx.Framework) {
+	byName := make(map[string]int, len(merged.Frameworks))
+	for i, f := range merged.Frameworks {
+		byName[f.Name] = i
+	}
+
+	for _, f := range incoming {
+		idx, ok := byName[f.Name]
+		if !ok {
+			merged.AddFramework(f)
+			byName[f.Name] = len(merged.Frameworks) - 1
+			continue
+		}
+		existing := &merged.Frameworks[idx]
+		if frameworkRank[f.Status] > frameworkRank[existing.Status] {
+			existing.Status = f.Status
+		}
+		if f.Score < existing.Score {
+			existing.Score = f.Score
+		}
+		mergeControlsInto(existing, f.Controls)
+	}
+}
+
+func mergeControlsInto(existing *cpx.Framework, incoming []cpx.Control) {
+	byID := make(map[string]int, len(existing.Controls))
+	for i, c := range existing.Controls {
+		byID[c.ID] = i
+	}
+
+	for _, c := range incoming {
+		idx, ok := byID[c.ID]
+		if !ok {
+			existing.Controls = append(existing.Controls, c)
+			byID[c.ID] = len(existing.Controls) - 1
+			continue
+		}
+		if controlRank[c.Status] > controlRank[existing.Controls[idx].Status] {
+			existing.Controls[idx].Status = c.Status
+			existing.Controls[idx].Reason = c.Reason
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package cpx
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Codec marshals a Posture into a specific wire format.
+type Codec interface {
+	// Marshal encodes p in this codec's format.
+	Marshal(p *Posture) ([]byte, error)
+	// ContentType is the MIME type this codec produces, e.g.
+	// "application/json". It doubles as the registry key.
+	ContentType() string
+}
+
+var (
+	codecsMu sync.RWMutex
+	codecs   = map[string]Codec{}
+
+	// formatAliases maps the legacy ?format= query values to the content
+	// type of the codec that should handle them, so links shared before
+	// content negotiation landed (and browsers, which can't set Accept)
+	// keep working.
+	formatAliases = map[string]string{
+		"json":     "application/json",
+		"yaml":     "application/yaml",
+		"cbor":     "application/cbor",
+		"protobuf": "application/vnd.opencpx.v1+protobuf",
+	}
+)
+
+// RegisterCodec makes c available for content negotiation under
+// c.ContentType(). Third parties can call this from an init() to add
+// formats without modifying Handler.
+func RegisterCodec(c Codec) {
+	codecsMu.Lock()
+	defer codecsMu.Unlock()
+	codecs[c.ContentType()] = c
+}
+
+func getCodec(contentType string) (Codec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+	c, ok := codecs[contentType]
+	return c, ok
+}
+
+// matchCodec returns the registered codec for want, picking deterministically
+// among ties: when a wildcard range (e.g. "application/*") matches more than
+// one registered content type, the lexicographically smallest wins, rather
+// than whichever codec a map iteration happens to visit first.
+func matchCodec(want mediaRange) (Codec, bool) {
+	codecsMu.RLock()
+	defer codecsMu.RUnlock()
+
+	var matched []string
+	for contentType := range codecs {
+		if want.matches(contentType) {
+			matched = append(matched, contentType)
+		}
+	}
+	if len(matched) == 0 {
+		return nil, false
+	}
+	sort.Strings(matched)
+	return codecs[matched[0]], true
+}
+
+// mediaRange is one entry of a parsed Accept header.
+type mediaRange struct {
+	typ, subtype string
+	q            float64
+}
+
+func (m mediaRange) matches(contentType string) bool {
+	typ, subtype, ok := strings.Cut(contentType, "/")
+	if !ok {
+		return false
+	}
+	if m.typ != "*" && m.typ != typ {
+		return false
+	}
+	if m.subtype != "*" && m.subtype != subtype {
+		return false
+	}
+	return true
+}
+
+// specificity ranks exact matches above type wildcards above "*/*", so
+// sorting is stable for ties on q.
+func (m mediaRange) specificity() int {
+	switch {
+	case m.typ != "*" && m.subtype != "*":
+		return 2
+	case m.typ != "*":
+		return 1
+	default:
+		return 0
+	}
+}
+
+func parseAccept(header string) []mediaRange {
+	if header == "" {
+		return nil
+	}
+
+	var ranges []mediaRange
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		segments := strings.Split(part, ";")
+		typ, subtype, ok := strings.Cut(strings.TrimSpace(segments[0]), "/")
+		if !ok {
+			continue
+		}
+
+		q := 1.0
+		for _, param := range segments[1:] {
+			param = strings.TrimSpace(param)
+			if v, ok := strings.CutPrefix(param, "q="); ok {
+				if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+					q = parsed
+				}
+			}
+		}
+
+		ranges = append(ranges, mediaRange{typ: typ, subtype: subtype, q: q})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		if ranges[i].q != ranges[j].q {
+			return ranges[i].q > ranges[j].q
+		}
+		return ranges[i].specificity() > ranges[j].specificity()
+	})
+	return ranges
+}
+
+// negotiateCodec picks a Codec for r, driven by the Accept header. The
+// legacy ?format= query parameter is only consulted when Accept is
+// absent or names nothing this server has registered other than through
+// a full "*/*" wildcard (as browsers send) — never to override a concrete
+// Accept entry a real client actually set. With neither deciding, it
+// picks JSON. It returns false only when ?format= names an unknown alias
+// or a codec that is not registered.
+func negotiateCodec(r *http.Request) (Codec, bool) {
+	if accept := r.Header.Get("Accept"); accept != "" && accept != "*/*" {
+		for _, want := range parseAccept(accept) {
+			if want.q == 0 || want.typ == "*" {
+				continue
+			}
+			if c, ok := matchCodec(want); ok {
+				return c, true
+			}
+		}
+		// Accept was present but unsatisfiable; fall through to ?format=.
+	}
+
+	if format := r.URL.Query().Get("format"); format != "" {
+		contentType, ok := formatAliases[format]
+		if !ok {
+			return nil, false
+		}
+		return getCodec(contentType)
+	}
+
+	return getCodec("application/json")
+}
+
+func unsupportedMediaTypeError() error {
+	return fmt.Errorf("cpx: no codec registered for the requested representation")
+}
+
+// textualContentTypes get a charset parameter; binary formats don't.
+var textualContentTypes = map[string]bool{
+	"application/json": true,
+	"application/yaml": true,
+}
+
+// contentTypeHeader builds the Content-Type header value for c, adding a
+// charset parameter for textual formats.
+func contentTypeHeader(c Codec) string {
+	ct := c.ContentType()
+	if textualContentTypes[ct] {
+		return ct + "; charset=utf-8"
+	}
+	return ct
+}
@@ -0,0 +1,19 @@
+package cpx
+
+import "github.com/fxamacker/cbor/v2"
+
+// cborCodec encodes Posture as CBOR (RFC 8949), reusing the same `json:`
+// struct tags via cbor's tag fallback.
+type cborCodec struct{}
+
+func (cborCodec) Marshal(p *Posture) ([]byte, error) {
+	return cbor.Marshal(p)
+}
+
+func (cborCodec) ContentType() string {
+	return "application/cbor"
+}
+
+func init() {
+	RegisterCodec(cborCodec{})
+}
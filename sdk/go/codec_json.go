@@ -0,0 +1,18 @@
+package cpx
+
+import "encoding/json"
+
+// jsonCodec encodes Posture as indented JSON.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(p *Posture) ([]byte, error) {
+	return json.MarshalIndent(p, "", "  ")
+}
+
+func (jsonCodec) ContentType() string {
+	return "application/json"
+}
+
+func init() {
+	RegisterCodec(jsonCodec{})
+}
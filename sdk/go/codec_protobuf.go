@@ -0,0 +1,67 @@
+package cpx
+
+import (
+	"time"
+
+	"github.com/LowerPlane/OpenCPX/sdk/go/pb"
+)
+
+// protobufCodec encodes Posture using the generated messages in cpx/pb.
+// EvidenceRefs and Extensions have no protobuf representation and are
+// dropped; see posture.proto for why.
+type protobufCodec struct{}
+
+func (protobufCodec) Marshal(p *Posture) ([]byte, error) {
+	return toProto(p).Marshal()
+}
+
+func (protobufCodec) ContentType() string {
+	return "application/vnd.opencpx.v1+protobuf"
+}
+
+func init() {
+	RegisterCodec(protobufCodec{})
+}
+
+func toProto(p *Posture) *pb.Posture {
+	out := &pb.Posture{
+		Version:           p.Version,
+		Timestamp:         p.Timestamp.Format(time.RFC3339),
+		CompliancePosture: string(p.CompliancePosture),
+	}
+	if p.Organization != nil {
+		out.Organization = &pb.Organization{
+			Name:    p.Organization.Name,
+			Domain:  p.Organization.Domain,
+			Contact: p.Organization.Contact,
+		}
+	}
+	for _, f := range p.Frameworks {
+		out.Frameworks = append(out.Frameworks, toProtoFramework(f))
+	}
+	return out
+}
+
+func toProtoFramework(f Framework) *pb.Framework {
+	out := &pb.Framework{
+		Name:           f.Name,
+		Version:        f.Version,
+		Status:         string(f.Status),
+		Score:          f.Score,
+		LastAudit:      f.LastAudit,
+		Auditor:        f.Auditor,
+		ReportRef:      f.ReportRef,
+		CertificateRef: f.CertificateRef,
+	}
+	for _, c := range f.Controls {
+		out.Controls = append(out.Controls, &pb.Control{
+			ID:              c.ID,
+			Title:           c.Title,
+			Status:          string(c.Status),
+			Reason:          c.Reason,
+			RemediationDate: c.RemediationDate,
+			EvidenceRefs:    c.EvidenceRefs,
+		})
+	}
+	return out
+}
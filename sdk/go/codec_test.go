@@ -0,0 +1,74 @@
+package cpx
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func newNegotiationRequest(accept, format string) *http.Request {
+	r := &http.Request{Header: http.Header{}, URL: &url.URL{}}
+	if accept != "" {
+		r.Header.Set("Accept", accept)
+	}
+	if format != "" {
+		q := url.Values{}
+		q.Set("format", format)
+		r.URL.RawQuery = q.Encode()
+	}
+	return r
+}
+
+func TestNegotiateCodecPrecedence(t *testing.T) {
+	tests := []struct {
+		name        string
+		accept      string
+		format      string
+		wantContent string
+		wantOK      bool
+	}{
+		{"accept exact match", "application/yaml", "", "application/yaml", true},
+		{"format only", "", "cbor", "application/cbor", true},
+		{"accept overrides stale format", "application/cbor", "json", "application/cbor", true},
+		{"wildcard accept falls back to format", "*/*", "protobuf", "application/vnd.opencpx.v1+protobuf", true},
+		{"unsatisfiable accept falls back to format", "application/xml", "yaml", "application/yaml", true},
+		{"unsatisfiable accept, no format, defaults to json", "application/xml", "", "application/json", true},
+		{"nothing set defaults to json", "", "", "application/json", true},
+		{"unknown format alias", "", "bogus", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, ok := negotiateCodec(newNegotiationRequest(tt.accept, tt.format))
+			if ok != tt.wantOK {
+				t.Fatalf("negotiateCodec() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if got := codec.ContentType(); got != tt.wantContent {
+				t.Errorf("negotiateCodec() content type = %q, want %q", got, tt.wantContent)
+			}
+		})
+	}
+}
+
+func TestNegotiateCodecWildcardIsDeterministic(t *testing.T) {
+	req := newNegotiationRequest("application/*", "")
+
+	codec, ok := negotiateCodec(req)
+	if !ok {
+		t.Fatal("negotiateCodec() ok = false, want true")
+	}
+	want := codec.ContentType()
+
+	for i := 0; i < 50; i++ {
+		codec, ok := negotiateCodec(newNegotiationRequest("application/*", ""))
+		if !ok {
+			t.Fatalf("iteration %d: negotiateCodec() ok = false, want true", i)
+		}
+		if got := codec.ContentType(); got != want {
+			t.Fatalf("iteration %d: negotiateCodec() content type = %q, want %q (non-deterministic)", i, got, want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package cpx
+
+import "sigs.k8s.io/yaml"
+
+// yamlCodec encodes Posture as YAML by round-tripping through encoding/json
+// first, so the YAML output follows the same `json:` struct tags as every
+// other representation instead of Go field names.
+type yamlCodec struct{}
+
+func (yamlCodec) Marshal(p *Posture) ([]byte, error) {
+	return yaml.Marshal(p)
+}
+
+func (yamlCodec) ContentType() string {
+	return "application/yaml"
+}
+
+func init() {
+	RegisterCodec(yamlCodec{})
+}
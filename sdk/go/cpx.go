@@ -124,6 +124,29 @@ func (p *Posture) AddExtension(key string, value interface{}) *Posture {
 	return p
 }
 
+// EvidenceReferences decodes the loosely-typed EvidenceRefs field into
+// strongly-typed EvidenceRef values, ignoring entries that don't match the
+// EvidenceRef shape.
+func (p *Posture) EvidenceReferences() ([]EvidenceRef, error) {
+	if len(p.EvidenceRefs) == 0 {
+		return nil, nil
+	}
+
+	var refs []EvidenceRef
+	for _, entry := range p.EvidenceRefs {
+		raw, err := json.Marshal(entry)
+		if err != nil {
+			continue
+		}
+		var ref EvidenceRef
+		if err := json.Unmarshal(raw, &ref); err != nil {
+			continue
+		}
+		refs = append(refs, ref)
+	}
+	return refs, nil
+}
+
 // ToJSON converts the posture to JSON bytes
 func (p *Posture) ToJSON() ([]byte, error) {
 	return json.Marshal(p)
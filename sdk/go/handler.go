@@ -1,15 +1,82 @@
 package cpx
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 )
 
+// Signer produces a detached signature over raw bytes, suitable for the
+// X-CPX-Signature header. Handler signs the exact bytes a Codec produced,
+// so the signature is valid for whatever representation was negotiated.
+// The cpx/sign package provides the standard JWS-based implementation.
+type Signer interface {
+	Sign(data []byte) (string, error)
+}
+
+// HashVerifier checks that a document's evidence references match their
+// declared content hash. The cpx/sign package provides the standard
+// implementation, which fetches EvidenceRef.URL and compares it against
+// EvidenceRef.Hash.
+type HashVerifier interface {
+	VerifyEvidence(ctx context.Context, refs []EvidenceRef) error
+}
+
+// WithSigner makes Handler sign every outgoing Posture with s and attach
+// the result as the X-CPX-Signature header.
+func WithSigner(s Signer) Option {
+	return func(c *handlerConfig) {
+		c.signer = s
+	}
+}
+
+// WithHashVerification makes Handler verify, before serving a response,
+// that every EvidenceRef with both a Hash and a URL set still hashes to
+// the declared value. A mismatch is reported as a 500, since it indicates
+// the provider is serving evidence that no longer matches what it claims.
+func WithHashVerification(v HashVerifier) Option {
+	return func(c *handlerConfig) {
+		c.hashVerifier = v
+	}
+}
+
 // Provider is a function that returns the current compliance posture
 type Provider func() (*Posture, error)
 
+// Validator checks a serialized Posture document against the OpenCPX
+// schema, returning one error per violation found. The cpx/schema package
+// provides the standard implementation; it is kept behind this interface
+// so that Handler doesn't need to depend on it directly.
+type Validator interface {
+	ValidateBytes(data []byte) ([]error, error)
+}
+
+// Option configures a Handler.
+type Option func(*handlerConfig)
+
+type handlerConfig struct {
+	validator    Validator
+	signer       Signer
+	hashVerifier HashVerifier
+}
+
+// WithValidator makes Handler run every outgoing Posture through v before
+// writing it to the response. Violations are reported to the caller as a
+// 400 instead of serving a non-conformant document; a validator error
+// itself (e.g. a malformed schema) is reported as a 500.
+func WithValidator(v Validator) Option {
+	return func(c *handlerConfig) {
+		c.validator = v
+	}
+}
+
 // Handler creates an HTTP handler for serving the CPX endpoint
-func Handler(provider Provider) http.HandlerFunc {
+func Handler(provider Provider, opts ...Option) http.HandlerFunc {
+	var cfg handlerConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodGet {
 			http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
@@ -22,38 +89,90 @@ func Handler(provider Provider) http.HandlerFunc {
 			return
 		}
 
-		format := r.URL.Query().Get("format")
+		if cfg.validator != nil {
+			if !validatePosture(w, cfg.validator, posture) {
+				return
+			}
+		}
+
+		if cfg.hashVerifier != nil {
+			refs, err := posture.EvidenceReferences()
+			if err != nil {
+				http.Error(w, "Failed to read evidence references", http.StatusInternalServerError)
+				return
+			}
+			if err := cfg.hashVerifier.VerifyEvidence(r.Context(), refs); err != nil {
+				http.Error(w, "Evidence hash verification failed: "+err.Error(), http.StatusInternalServerError)
+				return
+			}
+		}
 
-		var data []byte
-		var contentType string
+		w.Header().Set("Vary", "Accept")
 
-		switch format {
-		case "yaml":
-			// For YAML support, you can add a YAML library
-			// For now, we'll return JSON with a note
-			w.Header().Set("Content-Type", "application/json")
-			http.Error(w, `{"error": "YAML format requires gopkg.in/yaml.v3"}`, http.StatusNotImplemented)
+		codec, ok := negotiateCodec(r)
+		if !ok {
+			http.Error(w, unsupportedMediaTypeError().Error(), http.StatusNotAcceptable)
 			return
-		default:
-			data, err = json.MarshalIndent(posture, "", "  ")
-			contentType = "application/json"
 		}
 
+		data, err := codec.Marshal(posture)
 		if err != nil {
 			http.Error(w, "Failed to serialize posture", http.StatusInternalServerError)
 			return
 		}
 
-		w.Header().Set("Content-Type", contentType)
+		if cfg.signer != nil {
+			sig, err := cfg.signer.Sign(data)
+			if err != nil {
+				http.Error(w, "Failed to sign posture", http.StatusInternalServerError)
+				return
+			}
+			w.Header().Set("X-CPX-Signature", sig)
+		}
+
+		w.Header().Set("Content-Type", contentTypeHeader(codec))
 		w.Header().Set("X-CPX-Version", Version)
 		w.WriteHeader(http.StatusOK)
 		w.Write(data)
 	}
 }
 
+// validatePosture runs posture through v and, if it fails validation,
+// writes the appropriate error response. It returns false when the caller
+// should stop processing the request.
+func validatePosture(w http.ResponseWriter, v Validator, posture *Posture) bool {
+	raw, err := json.Marshal(posture)
+	if err != nil {
+		http.Error(w, "Failed to serialize posture", http.StatusInternalServerError)
+		return false
+	}
+
+	violations, err := v.ValidateBytes(raw)
+	if err != nil {
+		http.Error(w, "Schema validation error", http.StatusInternalServerError)
+		return false
+	}
+	if len(violations) == 0 {
+		return true
+	}
+
+	messages := make([]string, len(violations))
+	for i, v := range violations {
+		messages[i] = v.Error()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error":      "posture document fails OpenCPX v1 schema validation",
+		"violations": messages,
+	})
+	return false
+}
+
 // Middleware wraps an existing handler and adds CPX endpoint support
-func Middleware(provider Provider, next http.Handler) http.Handler {
-	cpxHandler := Handler(provider)
+func Middleware(provider Provider, next http.Handler, opts ...Option) http.Handler {
+	cpxHandler := Handler(provider, opts...)
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path == "/cpx" {
@@ -65,6 +184,6 @@ func Middleware(provider Provider, next http.Handler) http.Handler {
 }
 
 // RegisterHandler registers the CPX handler at the /cpx path
-func RegisterHandler(mux *http.ServeMux, provider Provider) {
-	mux.HandleFunc("/cpx", Handler(provider))
+func RegisterHandler(mux *http.ServeMux, provider Provider, opts ...Option) {
+	mux.HandleFunc("/cpx", Handler(provider, opts...))
 }
@@ -0,0 +1,90 @@
+package operator
+
+import (
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+// frameworkRank orders FrameworkStatus from best to worst so Aggregate can
+// pick the worst-of when the same framework is reported by multiple CRs.
+var frameworkRank = map[cpx.FrameworkStatus]int{
+	cpx.StatusCompliant:    0,
+	cpx.StatusPartial:      1,
+	cpx.StatusNonCompliant: 2,
+}
+
+// controlRank orders ControlStatus from best to worst, mirroring
+// frameworkRank.
+var controlRank = map[cpx.ControlStatus]int{
+	cpx.ControlCompliant:    0,
+	cpx.ControlPartial:      1,
+	cpx.ControlNonCompliant: 2,
+}
+
+// Aggregate merges the Frameworks declared by a set of CompliancePosture
+// CRs belonging to the same organization into a single *cpx.Posture.
+// Frameworks are unioned by name and Controls within them by ID; when the
+// same Framework or Control is declared more than once, the worst-of the
+// reported statuses wins.
+func Aggregate(organization string, crs []CompliancePosture) *cpx.Posture {
+	frameworksByName := map[string]*cpx.Framework{}
+	order := []string{}
+
+	for _, cr := range crs {
+		for _, f := range cr.Spec.Frameworks {
+			existing, ok := frameworksByName[f.Name]
+			if !ok {
+				merged := f
+				merged.Controls = append([]cpx.Control(nil), f.Controls...)
+				frameworksByName[f.Name] = &merged
+				order = append(order, f.Name)
+				continue
+			}
+			mergeFramework(existing, f)
+		}
+	}
+
+	p := cpx.NewPosture()
+	if organization != "" {
+		p.SetOrganization(cpx.Organization{Name: organization})
+	}
+	for _, name := range order {
+		p.AddFramework(*frameworksByName[name])
+	}
+	p.SetPosture(p.CalculateOverallPosture())
+	return p
+}
+
+// mergeFramework folds incoming into existing in place, taking the
+// worst-of Status and unioning Controls by ID.
+func mergeFramework(existing *cpx.Framework, incoming cpx.Framework) {
+	if frameworkRank[incoming.Status] > frameworkRank[existing.Status] {
+		existing.Status = incoming.Status
+	}
+	if incoming.Score < existing.Score {
+		existing.Score = incoming.Score
+	}
+
+	controlsByID := make(map[string]int, len(existing.Controls))
+	for i, c := range existing.Controls {
+		controlsByID[c.ID] = i
+	}
+
+	for _, c := range incoming.Controls {
+		idx, ok := controlsByID[c.ID]
+		if !ok {
+			existing.Controls = append(existing.Controls, c)
+			controlsByID[c.ID] = len(existing.Controls) - 1
+			continue
+		}
+		mergeControl(&existing.Controls[idx], c)
+	}
+}
+
+// mergeControl folds incoming into existing in place, taking the worst-of
+// Status.
+func mergeControl(existing *cpx.Control, incoming cpx.Control) {
+	if controlRank[incoming.Status] > controlRank[existing.Status] {
+		existing.Status = incoming.Status
+		existing.Reason = incoming.Reason
+	}
+}
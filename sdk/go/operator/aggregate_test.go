@@ -0,0 +1,75 @@
+package operator
+
+import (
+	"testing"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+func TestAggregateTakesWorstOfAcrossCRs(t *testing.T) {
+	crs := []CompliancePosture{
+		{Spec: CompliancePostureSpec{
+			Organization: "acme",
+			Frameworks: []cpx.Framework{
+				{
+					Name:   "soc2",
+					Status: cpx.StatusCompliant,
+					Score:  1.0,
+					Controls: []cpx.Control{
+						{ID: "cc-1", Status: cpx.ControlCompliant},
+					},
+				},
+			},
+		}},
+		{Spec: CompliancePostureSpec{
+			Organization: "acme",
+			Frameworks: []cpx.Framework{
+				{
+					Name:   "soc2",
+					Status: cpx.StatusNonCompliant,
+					Score:  0.4,
+					Controls: []cpx.Control{
+						{ID: "cc-1", Status: cpx.ControlPartial, Reason: "pending evidence"},
+						{ID: "cc-2", Status: cpx.ControlCompliant},
+					},
+				},
+			},
+		}},
+	}
+
+	posture := Aggregate("acme", crs)
+
+	if len(posture.Frameworks) != 1 {
+		t.Fatalf("len(Frameworks) = %d, want 1", len(posture.Frameworks))
+	}
+	fw := posture.Frameworks[0]
+
+	if fw.Status != cpx.StatusNonCompliant {
+		t.Errorf("Framework.Status = %q, want %q (worst-of)", fw.Status, cpx.StatusNonCompliant)
+	}
+	if fw.Score != 0.4 {
+		t.Errorf("Framework.Score = %v, want 0.4 (worst-of)", fw.Score)
+	}
+	if len(fw.Controls) != 2 {
+		t.Fatalf("len(Controls) = %d, want 2 (unioned by ID)", len(fw.Controls))
+	}
+
+	byID := map[string]cpx.Control{}
+	for _, c := range fw.Controls {
+		byID[c.ID] = c
+	}
+
+	if got := byID["cc-1"].Status; got != cpx.ControlPartial {
+		t.Errorf("Control cc-1 Status = %q, want %q (worst-of)", got, cpx.ControlPartial)
+	}
+	if got := byID["cc-2"].Status; got != cpx.ControlCompliant {
+		t.Errorf("Control cc-2 Status = %q, want %q", got, cpx.ControlCompliant)
+	}
+}
+
+func TestAggregateEmptyCRsYieldsUnknownPosture(t *testing.T) {
+	posture := Aggregate("acme", nil)
+	if posture.CompliancePosture != cpx.PostureUnknown {
+		t.Errorf("CompliancePosture = %q, want %q", posture.CompliancePosture, cpx.PostureUnknown)
+	}
+}
@@ -0,0 +1,86 @@
+package operator
+
+import (
+	"context"
+	"sync"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+// Reconciler watches CompliancePosture CRs across the cluster and keeps an
+// in-memory, per-organization aggregated *cpx.Posture up to date. Its
+// Provider method can be passed directly to cpx.Handler or
+// cpx.RegisterHandler.
+type Reconciler struct {
+	client.Client
+
+	mu       sync.RWMutex
+	postures map[string]*cpx.Posture
+}
+
+// NewReconciler returns a Reconciler backed by c. Call SetupWithManager to
+// start watching CompliancePosture CRs.
+func NewReconciler(c client.Client) *Reconciler {
+	return &Reconciler{
+		Client:   c,
+		postures: map[string]*cpx.Posture{},
+	}
+}
+
+// SetupWithManager registers the reconciler with mgr, watching all
+// CompliancePosture CRs in the cluster.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&CompliancePosture{}).
+		Complete(r)
+}
+
+// Reconcile recomputes the aggregated posture for every organization with at
+// least one CompliancePosture CR in the cluster, and clears the cached
+// posture for any organization that no longer has one. It re-lists rather
+// than keying off the triggering CR's Spec.Organization because on deletion
+// that CR (and its Organization) is already gone, yet the affected
+// organization's cache still needs refreshing.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	var all CompliancePostureList
+	if err := r.List(ctx, &all); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	byOrg := map[string][]CompliancePosture{}
+	for _, cr := range all.Items {
+		org := cr.Spec.Organization
+		if org == "" {
+			org = "default"
+		}
+		byOrg[org] = append(byOrg[org], cr)
+	}
+
+	postures := make(map[string]*cpx.Posture, len(byOrg))
+	for org, crs := range byOrg {
+		postures[org] = Aggregate(org, crs)
+	}
+
+	r.mu.Lock()
+	r.postures = postures
+	r.mu.Unlock()
+
+	return ctrl.Result{}, nil
+}
+
+// Provider returns the current aggregated posture for organization. It
+// satisfies cpx.Provider and can be passed directly to cpx.Handler.
+func (r *Reconciler) Provider(organization string) cpx.Provider {
+	return func() (*cpx.Posture, error) {
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+
+		if p, ok := r.postures[organization]; ok {
+			return p, nil
+		}
+		return cpx.NewPosture(), nil
+	}
+}
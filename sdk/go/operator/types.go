@@ -0,0 +1,114 @@
+// Package operator provides a controller-runtime reconciler that watches
+// CompliancePosture custom resources across a cluster and aggregates them
+// into a single cpx.Posture per organization, served through the existing
+// cpx.Handler.
+package operator
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+// GroupVersion is the API group and version CompliancePosture is served
+// under: opencpx.io/v1.
+var GroupVersion = schema.GroupVersion{Group: "opencpx.io", Version: "v1"}
+
+// CompliancePosture is the CustomResource workloads use to declare the
+// controls they satisfy. Its Spec mirrors cpx.Posture; the operator fills
+// in Version and Timestamp itself when aggregating, so a CR only needs to
+// set Organization and Frameworks.
+type CompliancePosture struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CompliancePostureSpec   `json:"spec"`
+	Status CompliancePostureStatus `json:"status,omitempty"`
+}
+
+// CompliancePostureSpec is the user-supplied part of a CompliancePosture CR.
+type CompliancePostureSpec struct {
+	// Organization groups CRs that should be aggregated together. CRs
+	// without one are aggregated under the "default" organization.
+	Organization string          `json:"organization,omitempty"`
+	Frameworks   []cpx.Framework `json:"frameworks"`
+}
+
+// CompliancePostureStatus holds the outcome of an aggregation that
+// included this CR. The Reconciler in this package does not write it.
+type CompliancePostureStatus struct {
+	ObservedGeneration int64       `json:"observedGeneration,omitempty"`
+	LastAggregated     metav1.Time `json:"lastAggregated,omitempty"`
+}
+
+// CompliancePostureList is a list of CompliancePosture resources.
+type CompliancePostureList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompliancePosture `json:"items"`
+}
+
+// DeepCopyObject implements runtime.Object so CompliancePosture can be used
+// with the controller-runtime client and caches.
+func (c *CompliancePosture) DeepCopyObject() runtime.Object {
+	out := new(CompliancePosture)
+	*out = *c
+	out.ObjectMeta = *c.ObjectMeta.DeepCopy()
+	out.Spec.Frameworks = deepCopyFrameworks(c.Spec.Frameworks)
+	c.Status.LastAggregated.DeepCopyInto(&out.Status.LastAggregated)
+	return out
+}
+
+// deepCopyFrameworks copies frameworks along with each Framework's Controls
+// and each Control's EvidenceRefs, so the result shares no backing array
+// with frameworks at any depth. The controller-runtime client and informer
+// caches rely on DeepCopyObject returning a value callers can mutate
+// freely without corrupting the cached original.
+func deepCopyFrameworks(frameworks []cpx.Framework) []cpx.Framework {
+	if frameworks == nil {
+		return nil
+	}
+	out := make([]cpx.Framework, len(frameworks))
+	for i, f := range frameworks {
+		out[i] = f
+		out[i].Controls = deepCopyControls(f.Controls)
+	}
+	return out
+}
+
+func deepCopyControls(controls []cpx.Control) []cpx.Control {
+	if controls == nil {
+		return nil
+	}
+	out := make([]cpx.Control, len(controls))
+	for i, c := range controls {
+		out[i] = c
+		out[i].EvidenceRefs = append([]string(nil), c.EvidenceRefs...)
+	}
+	return out
+}
+
+// DeepCopyObject implements runtime.Object so CompliancePostureList can be
+// used with the controller-runtime client and caches.
+func (l *CompliancePostureList) DeepCopyObject() runtime.Object {
+	out := new(CompliancePostureList)
+	out.TypeMeta = l.TypeMeta
+	out.ListMeta = *l.ListMeta.DeepCopy()
+	if l.Items != nil {
+		out.Items = make([]CompliancePosture, len(l.Items))
+		for i := range l.Items {
+			out.Items[i] = *l.Items[i].DeepCopyObject().(*CompliancePosture)
+		}
+	}
+	return out
+}
+
+// AddToScheme registers CompliancePosture and CompliancePostureList with
+// the given scheme.
+func AddToScheme(scheme *runtime.Scheme) error {
+	scheme.AddKnownTypes(GroupVersion, &CompliancePosture{}, &CompliancePostureList{})
+	metav1.AddToGroupVersion(scheme, GroupVersion)
+	return nil
+}
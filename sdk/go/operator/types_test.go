@@ -0,0 +1,40 @@
+package operator
+
+import (
+	"testing"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+func TestDeepCopyObjectIsIndependent(t *testing.T) {
+	original := &CompliancePosture{
+		Spec: CompliancePostureSpec{
+			Organization: "acme",
+			Frameworks: []cpx.Framework{
+				{
+					Name:   "soc2",
+					Status: cpx.StatusCompliant,
+					Controls: []cpx.Control{
+						{ID: "cc-1", Status: cpx.ControlCompliant, EvidenceRefs: []string{"ev-1", "ev-2"}},
+					},
+				},
+			},
+		},
+	}
+
+	copied := original.DeepCopyObject().(*CompliancePosture)
+
+	copied.Spec.Frameworks[0].Status = cpx.StatusNonCompliant
+	copied.Spec.Frameworks[0].Controls[0].Status = cpx.ControlNonCompliant
+	copied.Spec.Frameworks[0].Controls[0].EvidenceRefs[0] = "tampered"
+
+	if got := original.Spec.Frameworks[0].Status; got != cpx.StatusCompliant {
+		t.Errorf("original Framework.Status = %q, want unchanged %q", got, cpx.StatusCompliant)
+	}
+	if got := original.Spec.Frameworks[0].Controls[0].Status; got != cpx.ControlCompliant {
+		t.Errorf("original Control.Status = %q, want unchanged %q", got, cpx.ControlCompliant)
+	}
+	if got := original.Spec.Frameworks[0].Controls[0].EvidenceRefs[0]; got != "ev-1" {
+		t.Errorf("original Control.EvidenceRefs[0] = %q, want unchanged %q", got, "ev-1")
+	}
+}
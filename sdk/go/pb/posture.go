@@ -0,0 +1,217 @@
+package pb
+
+// Posture is the protobuf message described by posture.proto.
+type Posture struct {
+	Version           string
+	Timestamp         string
+	CompliancePosture string
+	Organization      *Organization
+	Frameworks        []*Framework
+}
+
+// Organization is the protobuf message described by posture.proto.
+type Organization struct {
+	Name    string
+	Domain  string
+	Contact string
+}
+
+// Framework is the protobuf message described by posture.proto.
+type Framework struct {
+	Name           string
+	Version        string
+	Status         string
+	Score          float64
+	LastAudit      string
+	Auditor        string
+	ReportRef      string
+	CertificateRef string
+	Controls       []*Control
+}
+
+// Control is the protobuf message described by posture.proto.
+type Control struct {
+	ID              string
+	Title           string
+	Status          string
+	Reason          string
+	RemediationDate string
+	EvidenceRefs    []string
+}
+
+// Marshal encodes p using the wire format described by posture.proto.
+func (p *Posture) Marshal() ([]byte, error) {
+	var w writer
+	w.string(1, p.Version)
+	w.string(2, p.Timestamp)
+	w.string(3, p.CompliancePosture)
+	if p.Organization != nil {
+		org, err := p.Organization.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		w.message(4, org)
+	}
+	for _, f := range p.Frameworks {
+		raw, err := f.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		w.message(5, raw)
+	}
+	return w.bytes(), nil
+}
+
+// Unmarshal decodes data, previously produced by Marshal, into p.
+func (p *Posture) Unmarshal(data []byte) error {
+	fields, err := (&reader{data: data}).fields()
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			p.Version = asString(f)
+		case 2:
+			p.Timestamp = asString(f)
+		case 3:
+			p.CompliancePosture = asString(f)
+		case 4:
+			org := &Organization{}
+			if err := org.Unmarshal(f.raw); err != nil {
+				return err
+			}
+			p.Organization = org
+		case 5:
+			fr := &Framework{}
+			if err := fr.Unmarshal(f.raw); err != nil {
+				return err
+			}
+			p.Frameworks = append(p.Frameworks, fr)
+		}
+	}
+	return nil
+}
+
+// Marshal encodes o using the wire format described by posture.proto.
+func (o *Organization) Marshal() ([]byte, error) {
+	var w writer
+	w.string(1, o.Name)
+	w.string(2, o.Domain)
+	w.string(3, o.Contact)
+	return w.bytes(), nil
+}
+
+// Unmarshal decodes data, previously produced by Marshal, into o.
+func (o *Organization) Unmarshal(data []byte) error {
+	fields, err := (&reader{data: data}).fields()
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			o.Name = asString(f)
+		case 2:
+			o.Domain = asString(f)
+		case 3:
+			o.Contact = asString(f)
+		}
+	}
+	return nil
+}
+
+// Marshal encodes f using the wire format described by posture.proto.
+func (f *Framework) Marshal() ([]byte, error) {
+	var w writer
+	w.string(1, f.Name)
+	w.string(2, f.Version)
+	w.string(3, f.Status)
+	w.double(4, f.Score)
+	w.string(5, f.LastAudit)
+	w.string(6, f.Auditor)
+	w.string(7, f.ReportRef)
+	w.string(8, f.CertificateRef)
+	for _, c := range f.Controls {
+		raw, err := c.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		w.message(9, raw)
+	}
+	return w.bytes(), nil
+}
+
+// Unmarshal decodes data, previously produced by Marshal, into fr.
+func (fr *Framework) Unmarshal(data []byte) error {
+	fields, err := (&reader{data: data}).fields()
+	if err != nil {
+		return err
+	}
+	for _, field := range fields {
+		switch field.num {
+		case 1:
+			fr.Name = asString(field)
+		case 2:
+			fr.Version = asString(field)
+		case 3:
+			fr.Status = asString(field)
+		case 4:
+			fr.Score = asDouble(field)
+		case 5:
+			fr.LastAudit = asString(field)
+		case 6:
+			fr.Auditor = asString(field)
+		case 7:
+			fr.ReportRef = asString(field)
+		case 8:
+			fr.CertificateRef = asString(field)
+		case 9:
+			c := &Control{}
+			if err := c.Unmarshal(field.raw); err != nil {
+				return err
+			}
+			fr.Controls = append(fr.Controls, c)
+		}
+	}
+	return nil
+}
+
+// Marshal encodes c using the wire format described by posture.proto.
+func (c *Control) Marshal() ([]byte, error) {
+	var w writer
+	w.string(1, c.ID)
+	w.string(2, c.Title)
+	w.string(3, c.Status)
+	w.string(4, c.Reason)
+	w.string(5, c.RemediationDate)
+	for _, ref := range c.EvidenceRefs {
+		w.string(6, ref)
+	}
+	return w.bytes(), nil
+}
+
+// Unmarshal decodes data, previously produced by Marshal, into c.
+func (c *Control) Unmarshal(data []byte) error {
+	fields, err := (&reader{data: data}).fields()
+	if err != nil {
+		return err
+	}
+	for _, f := range fields {
+		switch f.num {
+		case 1:
+			c.ID = asString(f)
+		case 2:
+			c.Title = asString(f)
+		case 3:
+			c.Status = asString(f)
+		case 4:
+			c.Reason = asString(f)
+		case 5:
+			c.RemediationDate = asString(f)
+		case 6:
+			c.EvidenceRefs = append(c.EvidenceRefs, asString(f))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Package pb provides the protobuf wire encoding for OpenCPX v1 documents,
+// as described by posture.proto, and is what backs the
+// application/vnd.opencpx.v1+protobuf codec registered by the cpx
+// package.
+package pb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+)
+
+type writer struct {
+	buf bytes.Buffer
+}
+
+func (w *writer) tag(fieldNum int, wireType int) {
+	writeVarint(&w.buf, uint64(fieldNum)<<3|uint64(wireType))
+}
+
+func (w *writer) string(fieldNum int, s string) {
+	if s == "" {
+		return
+	}
+	w.tag(fieldNum, wireBytes)
+	writeVarint(&w.buf, uint64(len(s)))
+	w.buf.WriteString(s)
+}
+
+func (w *writer) double(fieldNum int, f float64) {
+	if f == 0 {
+		return
+	}
+	w.tag(fieldNum, wireFixed64)
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], math.Float64bits(f))
+	w.buf.Write(b[:])
+}
+
+func (w *writer) message(fieldNum int, msg []byte) {
+	w.tag(fieldNum, wireBytes)
+	writeVarint(&w.buf, uint64(len(msg)))
+	w.buf.Write(msg)
+}
+
+func (w *writer) bytes() []byte {
+	return w.buf.Bytes()
+}
+
+func writeVarint(buf *bytes.Buffer, v uint64) {
+	for v >= 0x80 {
+		buf.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	buf.WriteByte(byte(v))
+}
+
+// reader walks a serialized message field by field; fields returns every
+// field encountered, in wire order.
+type reader struct {
+	data []byte
+}
+
+type field struct {
+	num      int
+	wireType int
+	varint   uint64
+	raw      []byte
+}
+
+func (r *reader) fields() ([]field, error) {
+	var fields []field
+	for len(r.data) > 0 {
+		key, n, err := readVarint(r.data)
+		if err != nil {
+			return nil, err
+		}
+		r.data = r.data[n:]
+
+		f := field{num: int(key >> 3), wireType: int(key & 0x7)}
+		switch f.wireType {
+		case wireVarint:
+			v, n, err := readVarint(r.data)
+			if err != nil {
+				return nil, err
+			}
+			f.varint = v
+			r.data = r.data[n:]
+		case wireFixed64:
+			if len(r.data) < 8 {
+				return nil, io.ErrUnexpectedEOF
+			}
+			f.varint = binary.LittleEndian.Uint64(r.data[:8])
+			r.data = r.data[8:]
+		case wireBytes:
+			l, n, err := readVarint(r.data)
+			if err != nil {
+				return nil, err
+			}
+			r.data = r.data[n:]
+			if uint64(len(r.data)) < l {
+				return nil, io.ErrUnexpectedEOF
+			}
+			f.raw = r.data[:l]
+			r.data = r.data[l:]
+		default:
+			return nil, fmt.Errorf("pb: unsupported wire type %d", f.wireType)
+		}
+		fields = append(fields, f)
+	}
+	return fields, nil
+}
+
+func readVarint(data []byte) (uint64, int, error) {
+	var v uint64
+	for i := 0; i < len(data); i++ {
+		b := data[i]
+		v |= uint64(b&0x7f) << (7 * i)
+		if b < 0x80 {
+			return v, i + 1, nil
+		}
+	}
+	return 0, 0, io.ErrUnexpectedEOF
+}
+
+func asString(f field) string {
+	return string(f.raw)
+}
+
+func asDouble(f field) float64 {
+	return math.Float64frombits(f.varint)
+}
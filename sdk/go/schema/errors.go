@@ -0,0 +1,25 @@
+package schema
+
+import "fmt"
+
+// ValidationError describes a single schema violation at a specific
+// location within the document, identified by a JSON-pointer path such as
+// "/frameworks/0/controls/2/status".
+type ValidationError struct {
+	Path    string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+func fail(violations *[]error, path, format string, args ...interface{}) {
+	*violations = append(*violations, &ValidationError{
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
@@ -0,0 +1,101 @@
+// Package schema provides JSON Schema validation for OpenCPX v1 documents.
+//
+// The schema itself is embedded at build time from spec.json, an OpenAPI 3
+// document describing the Posture, Framework, Control and EvidenceRef
+// shapes defined by github.com/LowerPlane/OpenCPX/sdk/go. Validator parses
+// that document once and can then check arbitrary Posture values, or raw
+// JSON bytes, against it.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+// node is a single JSON Schema node as found under components.schemas in
+// spec.json.
+type node struct {
+	Ref        string           `json:"$ref,omitempty"`
+	Type       string           `json:"type,omitempty"`
+	Required   []string         `json:"required,omitempty"`
+	Properties map[string]*node `json:"properties,omitempty"`
+	Items      *node            `json:"items,omitempty"`
+	Enum       []string         `json:"enum,omitempty"`
+}
+
+type document struct {
+	Components struct {
+		Schemas map[string]*node `json:"schemas"`
+	} `json:"components"`
+}
+
+// Validator checks OpenCPX v1 documents against the embedded schema.
+type Validator struct {
+	schemas map[string]*node
+	root    *node
+}
+
+// New parses the embedded OpenCPX v1 schema and returns a ready-to-use
+// Validator. It is safe to share a single Validator across goroutines.
+func New() (*Validator, error) {
+	var doc document
+	if err := json.Unmarshal(rawSpec, &doc); err != nil {
+		return nil, fmt.Errorf("schema: parse embedded spec: %w", err)
+	}
+	root, ok := doc.Components.Schemas["Posture"]
+	if !ok {
+		return nil, fmt.Errorf("schema: embedded spec has no Posture schema")
+	}
+	return &Validator{schemas: doc.Components.Schemas, root: root}, nil
+}
+
+// defaultValidator is parsed once at init so callers who don't need custom
+// construction can use the package-level Validate/ValidateBytes helpers.
+var defaultValidator *Validator
+
+func init() {
+	v, err := New()
+	if err != nil {
+		panic(err)
+	}
+	defaultValidator = v
+}
+
+// Validate checks a *cpx.Posture against the OpenCPX v1 schema, returning
+// one error per violation found. A nil slice means the document is valid.
+func (v *Validator) Validate(p *cpx.Posture) ([]error, error) {
+	raw, err := json.Marshal(p)
+	if err != nil {
+		return nil, fmt.Errorf("schema: marshal posture: %w", err)
+	}
+	return v.ValidateBytes(raw)
+}
+
+// ValidateBytes checks raw JSON against the OpenCPX v1 schema, returning
+// one error per violation found; the order of the violations is not
+// defined. The second return value
+// is non-nil only when the input could not be parsed as JSON at all.
+func (v *Validator) ValidateBytes(data []byte) ([]error, error) {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("schema: invalid JSON: %w", err)
+	}
+
+	var violations []error
+	walk(v.schemas, v.root, "", value, &violations)
+	return violations, nil
+}
+
+// Validate checks a *cpx.Posture against the embedded OpenCPX v1 schema
+// using the package's default Validator.
+func Validate(p *cpx.Posture) ([]error, error) {
+	return defaultValidator.Validate(p)
+}
+
+// ValidateBytes checks raw JSON against the embedded OpenCPX v1 schema
+// using the package's default Validator.
+func ValidateBytes(data []byte) ([]error, error) {
+	return defaultValidator.ValidateBytes(data)
+}
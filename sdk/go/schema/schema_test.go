@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"testing"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+func validPosture() *cpx.Posture {
+	return &cpx.Posture{
+		Version:           cpx.Version,
+		Timestamp:         cpx.NewPosture().Timestamp,
+		CompliancePosture: cpx.PostureCompliant,
+		Frameworks: []cpx.Framework{
+			{
+				Name:   "soc2",
+				Status: cpx.StatusCompliant,
+				Score:  1.0,
+				Controls: []cpx.Control{
+					{ID: "cc-1", Status: cpx.ControlCompliant},
+				},
+			},
+		},
+	}
+}
+
+func TestValidateAcceptsWellFormedPosture(t *testing.T) {
+	violations, err := Validate(validPosture())
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("violations = %v, want none", violations)
+	}
+}
+
+func TestValidateCollectsMultipleViolations(t *testing.T) {
+	p := validPosture()
+	p.CompliancePosture = "not_a_real_status"
+	p.Frameworks[0].Status = "also_not_real"
+	p.Frameworks[0].Controls[0].Status = ""
+
+	violations, err := Validate(p)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	if len(violations) < 2 {
+		t.Fatalf("len(violations) = %d, want at least 2, got %v", len(violations), violations)
+	}
+}
+
+func TestValidateDetectsMissingRequiredField(t *testing.T) {
+	violations, err := ValidateBytes([]byte(`{"version":"v1","timestamp":"now","frameworks":[]}`))
+	if err != nil {
+		t.Fatalf("ValidateBytes: %v", err)
+	}
+
+	found := false
+	for _, v := range violations {
+		ve, ok := v.(*ValidationError)
+		if ok && ve.Path == "/compliance_posture" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("violations = %v, want one for missing /compliance_posture", violations)
+	}
+}
+
+func TestValidateResolvesNestedRefs(t *testing.T) {
+	p := validPosture()
+	p.Frameworks[0].Controls[0].Status = "bogus"
+
+	violations, err := Validate(p)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+
+	found := false
+	for _, v := range violations {
+		ve, ok := v.(*ValidationError)
+		if ok && ve.Path == "/frameworks/0/controls/0/status" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("violations = %v, want one at /frameworks/0/controls/0/status", violations)
+	}
+}
+
+func TestValidateBytesRejectsInvalidJSON(t *testing.T) {
+	if _, err := ValidateBytes([]byte("not json")); err == nil {
+		t.Fatal("ValidateBytes(invalid JSON) = nil error, want one")
+	}
+}
+
+func TestValidateRejectsWrongFieldTypes(t *testing.T) {
+	violations, err := ValidateBytes([]byte(`{
+		"version": "v1",
+		"timestamp": "now",
+		"compliance_posture": "compliant",
+		"frameworks": "not-an-array"
+	}`))
+	if err != nil {
+		t.Fatalf("ValidateBytes: %v", err)
+	}
+	if len(violations) != 1 {
+		t.Fatalf("violations = %v, want exactly 1", violations)
+	}
+	if ve, ok := violations[0].(*ValidationError); !ok || ve.Path != "/frameworks" {
+		t.Errorf("violations[0] = %v, want one at /frameworks", violations[0])
+	}
+}
@@ -0,0 +1,10 @@
+package schema
+
+import _ "embed"
+
+// rawSpec is the embedded OpenAPI 3 / JSON Schema definition of the OpenCPX
+// v1 document, covering Posture, Organization, Framework, Control and
+// EvidenceRef.
+//
+//go:embed spec.json
+var rawSpec []byte
@@ -0,0 +1,117 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+)
+
+// resolve follows a $ref such as "#/components/schemas/Framework" to its
+// target node. It panics on malformed refs since those can only come from
+// the embedded spec itself, never from user input.
+func resolve(schemas map[string]*node, n *node) *node {
+	if n == nil || n.Ref == "" {
+		return n
+	}
+	name := strings.TrimPrefix(n.Ref, "#/components/schemas/")
+	target, ok := schemas[name]
+	if !ok {
+		panic(fmt.Sprintf("schema: unresolved $ref %q", n.Ref))
+	}
+	return target
+}
+
+// walk validates value against n, appending one *ValidationError per
+// violation to violations. It recurses into objects and arrays so that all
+// violations in a document are collected, not just the first.
+func walk(schemas map[string]*node, n *node, path string, value interface{}, violations *[]error) {
+	n = resolve(schemas, n)
+	if n == nil {
+		return
+	}
+
+	switch n.Type {
+	case "object", "":
+		obj, ok := value.(map[string]interface{})
+		if !ok {
+			fail(violations, path, "expected object, got %s", typeName(value))
+			return
+		}
+		for _, req := range n.Required {
+			if _, ok := obj[req]; !ok {
+				fail(violations, joinPath(path, req), "missing required field")
+			}
+		}
+		for key, propSchema := range n.Properties {
+			v, ok := obj[key]
+			if !ok {
+				continue
+			}
+			walk(schemas, propSchema, joinPath(path, key), v, violations)
+		}
+
+	case "array":
+		arr, ok := value.([]interface{})
+		if !ok {
+			fail(violations, path, "expected array, got %s", typeName(value))
+			return
+		}
+		for i, elem := range arr {
+			walk(schemas, n.Items, fmt.Sprintf("%s/%d", path, i), elem, violations)
+		}
+
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			fail(violations, path, "expected string, got %s", typeName(value))
+			return
+		}
+		if len(n.Enum) > 0 && !contains(n.Enum, s) {
+			fail(violations, path, "value %q is not one of %v", s, n.Enum)
+		}
+
+	case "number", "integer":
+		if _, ok := value.(float64); !ok {
+			fail(violations, path, "expected %s, got %s", n.Type, typeName(value))
+		}
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			fail(violations, path, "expected boolean, got %s", typeName(value))
+		}
+
+	default:
+		fail(violations, path, "unsupported schema type %q", n.Type)
+	}
+}
+
+func joinPath(path, key string) string {
+	return path + "/" + key
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func typeName(value interface{}) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
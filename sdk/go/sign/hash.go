@@ -0,0 +1,97 @@
+package sign
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"strings"
+
+	cpx "github.com/LowerPlane/OpenCPX/sdk/go"
+)
+
+// HashVerifier checks that the content at each EvidenceRef.URL hashes to
+// its declared EvidenceRef.Hash. It implements cpx.HashVerifier, so it can
+// be passed directly to cpx.WithHashVerification.
+type HashVerifier struct {
+	client *http.Client
+}
+
+// NewHashVerifier returns a HashVerifier using http.DefaultClient. Call
+// WithClient to use a custom one (e.g. with a timeout).
+func NewHashVerifier() *HashVerifier {
+	return &HashVerifier{client: http.DefaultClient}
+}
+
+// WithClient sets the HTTP client used to fetch evidence and returns v for
+// chaining.
+func (v *HashVerifier) WithClient(c *http.Client) *HashVerifier {
+	v.client = c
+	return v
+}
+
+// VerifyEvidence fetches every ref with both a URL and a Hash set and
+// confirms the recomputed digest matches. Refs missing either field are
+// skipped, since they carry nothing to verify against.
+func (v *HashVerifier) VerifyEvidence(ctx context.Context, refs []cpx.EvidenceRef) error {
+	for _, ref := range refs {
+		if ref.URL == "" || ref.Hash == "" {
+			continue
+		}
+		if err := v.verifyOne(ctx, ref); err != nil {
+			return fmt.Errorf("evidence %q: %w", ref.URL, err)
+		}
+	}
+	return nil
+}
+
+func (v *HashVerifier) verifyOne(ctx context.Context, ref cpx.EvidenceRef) error {
+	algo, want, ok := strings.Cut(ref.Hash, ":")
+	if !ok {
+		return fmt.Errorf("hash %q is not of the form algorithm:hexdigest", ref.Hash)
+	}
+
+	h, err := hasherFor(algo)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ref.URL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetch: unexpected status %s", resp.Status)
+	}
+
+	if _, err := io.Copy(h, resp.Body); err != nil {
+		return fmt.Errorf("read: %w", err)
+	}
+
+	got := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(got, want) {
+		return fmt.Errorf("%s digest mismatch: declared %s, computed %s", algo, want, got)
+	}
+	return nil
+}
+
+func hasherFor(algo string) (hash.Hash, error) {
+	switch strings.ToLower(algo) {
+	case "sha256":
+		return sha256.New(), nil
+	case "sha512":
+		return sha512.New(), nil
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm %q", algo)
+	}
+}
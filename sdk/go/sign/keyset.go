@@ -0,0 +1,165 @@
+package sign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// KeySet resolves a JWS key id to the public key that should verify it.
+type KeySet interface {
+	Key(kid string) (crypto.PublicKey, bool)
+}
+
+// StaticKeySet is a KeySet backed by a fixed, caller-supplied map.
+type StaticKeySet map[string]crypto.PublicKey
+
+// Key implements KeySet.
+func (s StaticKeySet) Key(kid string) (crypto.PublicKey, bool) {
+	k, ok := s[kid]
+	return k, ok
+}
+
+// jwk is the subset of RFC 7517 JSON Web Key fields OpenCPX needs to
+// reconstruct RSA, EC and OKP (Ed25519) public keys.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Crv string `json:"crv,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("sign: decode RSA modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("sign: decode RSA exponent: %w", err)
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("sign: unsupported EC curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("sign: decode EC x: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("sign: decode EC y: %w", err)
+		}
+		return &ecdsa.PublicKey{
+			Curve: curve,
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}, nil
+
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("sign: unsupported OKP curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("sign: decode Ed25519 public key: %w", err)
+		}
+		return ed25519.PublicKey(x), nil
+
+	default:
+		return nil, fmt.Errorf("sign: unsupported key type %q", k.Kty)
+	}
+}
+
+// JWKSKeySet fetches a JSON Web Key Set from a URL and serves it as a
+// KeySet, refreshing it at most once per TTL.
+type JWKSKeySet struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// NewJWKSKeySet returns a KeySet that lazily fetches and caches the JWKS
+// document at url, refreshing it once ttl has elapsed since the last fetch.
+func NewJWKSKeySet(url string, ttl time.Duration) *JWKSKeySet {
+	return &JWKSKeySet{url: url, ttl: ttl, client: http.DefaultClient}
+}
+
+// Key implements KeySet, refreshing the cached JWKS document if it has
+// expired.
+func (s *JWKSKeySet) Key(kid string) (crypto.PublicKey, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.fetchedAt) > s.ttl {
+		if keys, err := s.fetch(); err == nil {
+			s.keys = keys
+			s.fetchedAt = time.Now()
+		}
+	}
+
+	k, ok := s.keys[kid]
+	return k, ok
+}
+
+func (s *JWKSKeySet) fetch() (map[string]crypto.PublicKey, error) {
+	resp, err := s.client.Get(s.url)
+	if err != nil {
+		return nil, fmt.Errorf("sign: fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("sign: fetch JWKS: unexpected status %s", resp.Status)
+	}
+
+	var doc jwks
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("sign: decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(doc.Keys))
+	for _, k := range doc.Keys {
+		pub, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = pub
+	}
+	return keys, nil
+}
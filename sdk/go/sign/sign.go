@@ -0,0 +1,141 @@
+// Package sign adds tamper-evidence to OpenCPX documents: detached JWS
+// (RFC 7515) signatures over the exact bytes a Handler serves, and hash
+// verification of the evidence a Posture references.
+package sign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// Signer signs Posture documents with a detached JWS using key. It
+// implements cpx.Signer, so it can be passed directly to
+// cpx.WithSigner.
+type Signer struct {
+	key crypto.Signer
+	kid string
+	alg string
+}
+
+// New returns a Signer backed by key. key must be an *rsa.PrivateKey,
+// *ecdsa.PrivateKey or ed25519.PrivateKey; kid identifies the key in the
+// JWS header so verifiers can pick the right one out of a KeySet.
+func New(key crypto.Signer, kid string) (*Signer, error) {
+	alg, err := algFor(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{key: key, kid: kid, alg: alg}, nil
+}
+
+func algFor(key crypto.Signer) (string, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return "RS256", nil
+	case *ecdsa.PrivateKey:
+		switch k.Curve.Params().BitSize {
+		case 256:
+			return "ES256", nil
+		case 384:
+			return "ES384", nil
+		case 521:
+			return "ES512", nil
+		default:
+			return "", fmt.Errorf("sign: unsupported ECDSA curve %s", k.Curve.Params().Name)
+		}
+	case ed25519.PrivateKey:
+		return "EdDSA", nil
+	default:
+		return "", fmt.Errorf("sign: unsupported key type %T", key)
+	}
+}
+
+// jwsHeader is the protected header of the detached JWS.
+type jwsHeader struct {
+	Alg string `json:"alg"`
+	Kid string `json:"kid,omitempty"`
+}
+
+// Sign produces a detached JWS compact serialization over data:
+// "<header>..<signature>", with the payload segment empty per RFC 7515
+// Appendix F. Verifiers must reattach the payload themselves before
+// checking the signature.
+//
+// data must be the exact bytes the caller is about to serve: Handler
+// signs whatever a Codec produced, so the signature is valid for the
+// representation actually sent (JSON, YAML, CBOR, ...), not a fixed
+// encoding of the Posture.
+func (s *Signer) Sign(data []byte) (string, error) {
+	header, err := json.Marshal(jwsHeader{Alg: s.alg, Kid: s.kid})
+	if err != nil {
+		return "", fmt.Errorf("sign: marshal header: %w", err)
+	}
+
+	protected := base64.RawURLEncoding.EncodeToString(header)
+	signingInput := protected + "." + base64.RawURLEncoding.EncodeToString(data)
+
+	sig, err := signBytes(s.key, s.alg, []byte(signingInput))
+	if err != nil {
+		return "", fmt.Errorf("sign: %w", err)
+	}
+
+	return protected + ".." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
+
+func signBytes(key crypto.Signer, alg string, signingInput []byte) ([]byte, error) {
+	switch alg {
+	case "RS256":
+		sum := sha256.Sum256(signingInput)
+		return key.Sign(rand.Reader, sum[:], crypto.SHA256)
+	case "ES256":
+		sum := sha256.Sum256(signingInput)
+		der, err := key.Sign(rand.Reader, sum[:], crypto.SHA256)
+		if err != nil {
+			return nil, err
+		}
+		return asn1ToRawECDSA(der, 32)
+	case "ES384":
+		sum := sha512.Sum384(signingInput)
+		der, err := key.Sign(rand.Reader, sum[:], crypto.SHA384)
+		if err != nil {
+			return nil, err
+		}
+		return asn1ToRawECDSA(der, 48)
+	case "ES512":
+		sum := sha512.Sum512(signingInput)
+		der, err := key.Sign(rand.Reader, sum[:], crypto.SHA512)
+		if err != nil {
+			return nil, err
+		}
+		return asn1ToRawECDSA(der, 66)
+	case "EdDSA":
+		return key.Sign(rand.Reader, signingInput, crypto.Hash(0))
+	default:
+		return nil, fmt.Errorf("sign: unsupported algorithm %q", alg)
+	}
+}
+
+// asn1ToRawECDSA converts the ASN.1 DER (r, s) pair crypto/ecdsa produces
+// into the fixed-width r||s concatenation JWS requires, padding each
+// coordinate to size bytes.
+func asn1ToRawECDSA(der []byte, size int) ([]byte, error) {
+	var sig struct{ R, S *big.Int }
+	if _, err := asn1.Unmarshal(der, &sig); err != nil {
+		return nil, fmt.Errorf("sign: decode ECDSA signature: %w", err)
+	}
+
+	raw := make([]byte, 2*size)
+	sig.R.FillBytes(raw[:size])
+	sig.S.FillBytes(raw[size:])
+	return raw, nil
+}
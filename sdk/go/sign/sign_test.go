@@ -0,0 +1,80 @@
+package sign
+
+import (
+	"crypto/ed25519"
+	"testing"
+)
+
+func TestSignVerifyRoundTrip(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	signer, err := New(priv, "test-key")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	for _, body := range [][]byte{
+		[]byte(`{"version":"v1"}`),
+		[]byte("version: v1\n"),
+		{0x01, 0x02, 0x03}, // a stand-in for CBOR/protobuf bytes
+	} {
+		sig, err := signer.Sign(body)
+		if err != nil {
+			t.Fatalf("Sign: %v", err)
+		}
+
+		keys := StaticKeySet{"test-key": pub}
+		if err := Verify(body, sig, keys); err != nil {
+			t.Errorf("Verify(%q) = %v, want nil", body, err)
+		}
+	}
+}
+
+func TestVerifyRejectsTamperedBody(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	signer, err := New(priv, "test-key")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	original := []byte(`{"version":"v1"}`)
+	sig, err := signer.Sign(original)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	tampered := []byte(`{"version":"v2"}`)
+	keys := StaticKeySet{"test-key": pub}
+	if err := Verify(tampered, sig, keys); err == nil {
+		t.Error("Verify(tampered) = nil, want an error")
+	}
+}
+
+func TestVerifyUnknownKeyID(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+
+	signer, err := New(priv, "test-key")
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	body := []byte(`{"version":"v1"}`)
+	sig, err := signer.Sign(body)
+	if err != nil {
+		t.Fatalf("Sign: %v", err)
+	}
+
+	if err := Verify(body, sig, StaticKeySet{}); err == nil {
+		t.Error("Verify with empty key set = nil, want an error")
+	}
+}
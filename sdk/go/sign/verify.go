@@ -0,0 +1,111 @@
+package sign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// Verify checks that sig, a detached JWS compact serialization produced by
+// Signer.Sign, is a valid signature over body by one of the keys in keys.
+// body must be the exact bytes served in the response (whatever
+// representation Content-Type names), not a re-marshaled or
+// re-serialized copy, since signatures are sensitive to the precise byte
+// sequence that was signed.
+func Verify(body []byte, sig string, keys KeySet) error {
+	parts := strings.Split(sig, ".")
+	if len(parts) != 3 || parts[1] != "" {
+		return fmt.Errorf("sign: malformed detached JWS")
+	}
+
+	headerRaw, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("sign: decode header: %w", err)
+	}
+	var header jwsHeader
+	if err := json.Unmarshal(headerRaw, &header); err != nil {
+		return fmt.Errorf("sign: parse header: %w", err)
+	}
+
+	sigBytes, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("sign: decode signature: %w", err)
+	}
+
+	key, ok := keys.Key(header.Kid)
+	if !ok {
+		return fmt.Errorf("sign: unknown key id %q", header.Kid)
+	}
+
+	signingInput := parts[0] + "." + base64.RawURLEncoding.EncodeToString(body)
+	if err := verifyBytes(key, header.Alg, []byte(signingInput), sigBytes); err != nil {
+		return fmt.Errorf("sign: signature verification failed: %w", err)
+	}
+	return nil
+}
+
+func verifyBytes(key crypto.PublicKey, alg string, signingInput, sig []byte) error {
+	switch alg {
+	case "RS256":
+		pub, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("expected RSA public key, got %T", key)
+		}
+		sum := sha256.Sum256(signingInput)
+		return rsa.VerifyPKCS1v15(pub, crypto.SHA256, sum[:], sig)
+
+	case "ES256", "ES384", "ES512":
+		pub, ok := key.(*ecdsa.PublicKey)
+		if !ok {
+			return fmt.Errorf("expected ECDSA public key, got %T", key)
+		}
+		size := map[string]int{"ES256": 32, "ES384": 48, "ES512": 66}[alg]
+		if len(sig) != 2*size {
+			return fmt.Errorf("unexpected signature length %d for %s", len(sig), alg)
+		}
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		der, err := asn1.Marshal(struct{ R, S *big.Int }{r, s})
+		if err != nil {
+			return err
+		}
+		var digest []byte
+		switch alg {
+		case "ES256":
+			sum := sha256.Sum256(signingInput)
+			digest = sum[:]
+		case "ES384":
+			sum := sha512.Sum384(signingInput)
+			digest = sum[:]
+		case "ES512":
+			sum := sha512.Sum512(signingInput)
+			digest = sum[:]
+		}
+		if !ecdsa.VerifyASN1(pub, digest, der) {
+			return fmt.Errorf("invalid ECDSA signature")
+		}
+		return nil
+
+	case "EdDSA":
+		pub, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return fmt.Errorf("expected Ed25519 public key, got %T", key)
+		}
+		if !ed25519.Verify(pub, signingInput, sig) {
+			return fmt.Errorf("invalid Ed25519 signature")
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported algorithm %q", alg)
+	}
+}